@@ -3,11 +3,22 @@
 package pgconnect
 
 import (
+	"fmt"
+
 	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
 )
 
 // Config holds database connection configuration
 type Config struct {
+	// Driver selects the gorm dialect to open, looked up in GormDriver.
+	// Defaults to "postgres" when empty.
+	Driver string
+	// RawDSN, when set, is passed to the driver verbatim instead of the DSN
+	// assembled from the fields below. Required for drivers other than
+	// Postgres, e.g. Driver: "mysql" with RawDSN: "user:pass@tcp(host)/db".
+	RawDSN string
+
 	Host         string
 	Port         string
 	User         string
@@ -18,11 +29,22 @@ type Config struct {
 	MaxIdleConns int
 	MaxOpenConns int
 	LogLevel     logger.LogLevel
+	// Logger, when set, is used as-is instead of logger.Default.LogMode(LogLevel).
+	// Use WithZapLogger to adapt a *zap.Logger.
+	Logger logger.Interface
+	// NamingStrategy overrides how GORM derives table and column names, e.g.
+	// schema.NamingStrategy{TablePrefix: "tenant_", SingularTable: true}.
+	// Left nil, GORM's own default naming strategy is used.
+	NamingStrategy schema.Namer
+	// PrepareStmt caches prepared statements across queries, trading memory
+	// for avoiding re-parsing SQL on hot query paths.
+	PrepareStmt bool
 }
 
 // DefaultConfig returns a Config with sensible defaults
 func DefaultConfig() Config {
 	return Config{
+		Driver:       "postgres",
 		Host:         "localhost",
 		Port:         "5432",
 		User:         "postgres",
@@ -35,3 +57,16 @@ func DefaultConfig() Config {
 		LogLevel:     logger.Silent,
 	}
 }
+
+// DSN returns the data source name to hand to the driver. If RawDSN is set
+// it is returned as-is; otherwise a Postgres-style DSN is assembled from the
+// discrete connection fields.
+func (c Config) DSN() string {
+	if c.RawDSN != "" {
+		return c.RawDSN
+	}
+	return fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s TimeZone=%s",
+		c.Host, c.Port, c.User, c.Password, c.DatabaseName, c.SSLMode, c.TimeZone,
+	)
+}
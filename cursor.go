@@ -0,0 +1,119 @@
+package pgconnect
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Cursor is an opaque, base64-encoded keyset pagination token. It encodes
+// the order column, the last seen row's value for that column, and the sort
+// direction, so callers can round-trip it through an API without knowing its
+// internal shape.
+type Cursor string
+
+// cursorPayload is the decoded form of a Cursor: the order column, its typed
+// value on the last row of the previous page, and the sort direction.
+type cursorPayload struct {
+	Column string
+	Value  interface{}
+	Desc   bool
+}
+
+// cursorWireFormat is the JSON shape encoded inside a Cursor. Value is kept
+// as a json.RawMessage (rather than interface{}) so decode can parse it with
+// json.Number instead of routing integers through a lossy float64, and Kind
+// records the original Go kind so the numeric value can be reconstructed
+// precisely.
+type cursorWireFormat struct {
+	Column string          `json:"c"`
+	Kind   string          `json:"k"`
+	Value  json.RawMessage `json:"v"`
+	Desc   bool            `json:"d"`
+}
+
+// encodeCursor builds a Cursor from the order column, the value of that
+// column on the last row of a page, and the sort direction.
+func encodeCursor(column string, value interface{}, desc bool) (Cursor, error) {
+	rawValue, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("pgconnect: failed to encode cursor: %w", err)
+	}
+
+	data, err := json.Marshal(cursorWireFormat{
+		Column: column,
+		Kind:   reflect.ValueOf(value).Kind().String(),
+		Value:  rawValue,
+		Desc:   desc,
+	})
+	if err != nil {
+		return "", fmt.Errorf("pgconnect: failed to encode cursor: %w", err)
+	}
+	return Cursor(base64.URLEncoding.EncodeToString(data)), nil
+}
+
+// decode unpacks the cursor back into its column, typed value, and
+// direction. Numeric values are decoded via json.Number and converted to
+// int64, uint64 or float64 according to the recorded kind, so int64/uint64
+// keys beyond 2^53 stay exact instead of rounding through float64.
+func (c Cursor) decode() (cursorPayload, error) {
+	var wire cursorWireFormat
+	data, err := base64.URLEncoding.DecodeString(string(c))
+	if err != nil {
+		return cursorPayload{}, fmt.Errorf("pgconnect: invalid cursor: %w", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&wire); err != nil {
+		return cursorPayload{}, fmt.Errorf("pgconnect: invalid cursor: %w", err)
+	}
+
+	value, err := decodeCursorValue(wire.Kind, wire.Value)
+	if err != nil {
+		return cursorPayload{}, err
+	}
+
+	return cursorPayload{Column: wire.Column, Value: value, Desc: wire.Desc}, nil
+}
+
+// decodeCursorValue parses raw (a JSON-encoded scalar) back into a Go value:
+// int64 for signed integer kinds, uint64 for unsigned ones and float64 for
+// float kinds, using json.Number so precision above 2^53 survives the round
+// trip. Other kinds are returned as decoded by encoding/json.
+func decodeCursorValue(kind string, raw json.RawMessage) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("pgconnect: invalid cursor value: %w", err)
+	}
+
+	num, isNumber := v.(json.Number)
+
+	switch kind {
+	case reflect.Int.String(), reflect.Int8.String(), reflect.Int16.String(),
+		reflect.Int32.String(), reflect.Int64.String():
+		if !isNumber {
+			return nil, fmt.Errorf("pgconnect: cursor value is not numeric for kind %q", kind)
+		}
+		return num.Int64()
+	case reflect.Uint.String(), reflect.Uint8.String(), reflect.Uint16.String(),
+		reflect.Uint32.String(), reflect.Uint64.String():
+		if !isNumber {
+			return nil, fmt.Errorf("pgconnect: cursor value is not numeric for kind %q", kind)
+		}
+		return strconv.ParseUint(num.String(), 10, 64)
+	case reflect.Float32.String(), reflect.Float64.String():
+		if !isNumber {
+			return nil, fmt.Errorf("pgconnect: cursor value is not numeric for kind %q", kind)
+		}
+		return num.Float64()
+	default:
+		return v, nil
+	}
+}
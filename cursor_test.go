@@ -0,0 +1,46 @@
+package pgconnect
+
+import "testing"
+
+func TestCursorRoundTrip(t *testing.T) {
+	cases := []struct {
+		name  string
+		value interface{}
+		desc  bool
+	}{
+		{"int64", int64(9007199254740993), false}, // 2^53 + 1, lossy as float64
+		{"uint64", uint64(18446744073709551615), true},
+		{"string", "01HZY7", false},
+		{"float64", 3.5, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cursor, err := encodeCursor("id", tc.value, tc.desc)
+			if err != nil {
+				t.Fatalf("encodeCursor: %v", err)
+			}
+
+			payload, err := cursor.decode()
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+
+			if payload.Column != "id" {
+				t.Errorf("Column = %v, want %q", payload.Column, "id")
+			}
+			if payload.Desc != tc.desc {
+				t.Errorf("Desc = %v, want %v", payload.Desc, tc.desc)
+			}
+			if payload.Value != tc.value {
+				t.Errorf("Value = %#v, want %#v", payload.Value, tc.value)
+			}
+		})
+	}
+}
+
+func TestCursorDecodeInvalid(t *testing.T) {
+	if _, err := Cursor("not-base64!!").decode(); err == nil {
+		t.Fatal("expected error decoding malformed cursor, got nil")
+	}
+}
@@ -1,10 +1,10 @@
 package pgconnect
 
 import (
+	"database/sql"
 	"fmt"
 	"time"
 
-	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
@@ -16,16 +16,28 @@ type DB struct {
 
 // New creates and returns a new database connection
 func New(cfg Config) (*DB, error) {
-	dsn := fmt.Sprintf(
-		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s TimeZone=%s",
-		cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.DatabaseName, cfg.SSLMode, cfg.TimeZone,
-	)
+	driver := cfg.Driver
+	if driver == "" {
+		driver = "postgres"
+	}
+
+	dialector, ok := GormDriver[driver]
+	if !ok {
+		return nil, fmt.Errorf("pgconnect: unknown driver %q", driver)
+	}
+
+	gormLogger := cfg.Logger
+	if gormLogger == nil {
+		gormLogger = logger.Default.LogMode(cfg.LogLevel)
+	}
 
 	gormConfig := &gorm.Config{
-		Logger: logger.Default.LogMode(cfg.LogLevel),
+		Logger:         gormLogger,
+		NamingStrategy: cfg.NamingStrategy,
+		PrepareStmt:    cfg.PrepareStmt,
 	}
 
-	db, err := gorm.Open(postgres.Open(dsn), gormConfig)
+	db, err := gorm.Open(dialector(cfg.DSN()), gormConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
@@ -70,3 +82,13 @@ func (db *DB) AutoMigrate(models ...interface{}) error {
 func (db *DB) WithTransaction(fn func(tx *gorm.DB) error) error {
 	return db.DB.Transaction(fn)
 }
+
+// Stats reports connection pool statistics for the underlying *sql.DB, for
+// health endpoints and metrics exporters.
+func (db *DB) Stats() sql.DBStats {
+	sqlDB, err := db.DB.DB()
+	if err != nil {
+		return sql.DBStats{}
+	}
+	return sqlDB.Stats()
+}
@@ -0,0 +1,28 @@
+package pgconnect
+
+import (
+	"gorm.io/driver/clickhouse"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/driver/sqlserver"
+	"gorm.io/gorm"
+)
+
+// GormDriver maps a driver name (as set on Config.Driver) to a constructor
+// that builds a gorm.Dialector from a DSN. Postgres, MySQL, SQLite, SQL
+// Server, and ClickHouse are registered by default.
+var GormDriver = map[string]func(dsn string) gorm.Dialector{
+	"postgres":   postgres.Open,
+	"mysql":      mysql.Open,
+	"sqlite":     sqlite.Open,
+	"sqlserver":  sqlserver.Open,
+	"clickhouse": clickhouse.Open,
+}
+
+// Register adds or overrides a driver constructor under name, so callers can
+// plug in additional gorm dialects (or swap a built-in one) without forking
+// pgconnect.
+func Register(name string, dialector func(dsn string) gorm.Dialector) {
+	GormDriver[name] = dialector
+}
@@ -0,0 +1,69 @@
+// Package ginhealth provides Gin handlers for liveness and readiness checks
+// backed by a pgconnect.DB.
+package ginhealth
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/JorgeSaicoski/pgconnect"
+)
+
+// ReadinessOptions configures the Readiness handler.
+type ReadinessOptions struct {
+	// Timeout bounds the context passed to Probe. Defaults to 2s. The
+	// connectivity ping does not take this context and is not bounded by it.
+	Timeout time.Duration
+	// Probe, if set, runs after the connectivity ping succeeds (e.g. a
+	// `SELECT 1` against a dependent schema). It receives a context bound by
+	// Timeout.
+	Probe func(ctx context.Context) error
+}
+
+// Liveness reports 200 as long as the process is up to handle requests; it
+// does not touch the database.
+func Liveness(db *pgconnect.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": "ok"})
+	}
+}
+
+// Readiness reports whether db is reachable (and the optional custom probe
+// passes), along with a snapshot of the connection pool stats.
+func Readiness(db *pgconnect.DB, opts ReadinessOptions) gin.HandlerFunc {
+	if opts.Timeout == 0 {
+		opts.Timeout = 2 * time.Second
+	}
+
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), opts.Timeout)
+		defer cancel()
+
+		if err := db.Ping(); err != nil {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"status": "error", "error": err.Error()})
+			return
+		}
+
+		if opts.Probe != nil {
+			if err := opts.Probe(ctx); err != nil {
+				c.JSON(http.StatusServiceUnavailable, gin.H{"status": "error", "error": err.Error()})
+				return
+			}
+		}
+
+		stats := db.Stats()
+		c.JSON(http.StatusOK, gin.H{
+			"status": "ok",
+			"pool": gin.H{
+				"in_use":               stats.InUse,
+				"idle":                 stats.Idle,
+				"wait_count":           stats.WaitCount,
+				"wait_duration_ms":     stats.WaitDuration.Milliseconds(),
+				"max_open_connections": stats.MaxOpenConnections,
+			},
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package pgconnect
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+)
+
+// defaultMigrationLockKey is the advisory lock name used by SafeAutoMigrate.
+const defaultMigrationLockKey = "pgconnect:migrator"
+
+// SafeAutoMigrate runs AutoMigrate guarded by a PostgreSQL advisory lock, so
+// that multiple replicas booting at the same time don't race on the
+// index/constraint creation GORM's AutoMigrate performs.
+func (db *DB) SafeAutoMigrate(models ...interface{}) error {
+	return db.SafeAutoMigrateWithKey(defaultMigrationLockKey, models...)
+}
+
+// SafeAutoMigrateWithKey behaves like SafeAutoMigrate but takes an explicit
+// lock key, so callers running independent migration groups can use
+// distinct keys instead of blocking on each other.
+func (db *DB) SafeAutoMigrateWithKey(lockKey string, models ...interface{}) error {
+	sqlDB, err := db.DB.DB()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	// Pin the lock to a single backend connection so the unlock below is
+	// guaranteed to run against the same session that acquired it.
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire migration connection: %w", err)
+	}
+	defer conn.Close()
+
+	key := advisoryLockKey(lockKey)
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", key); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", key)
+
+	return db.DB.AutoMigrate(models...)
+}
+
+// advisoryLockKey derives a stable int64 lock key from name via FNV-64a.
+func advisoryLockKey(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	return int64(h.Sum64())
+}
@@ -0,0 +1,19 @@
+package pgconnect
+
+import "testing"
+
+func TestAdvisoryLockKeyStable(t *testing.T) {
+	a := advisoryLockKey(defaultMigrationLockKey)
+	b := advisoryLockKey(defaultMigrationLockKey)
+	if a != b {
+		t.Errorf("advisoryLockKey(%q) not stable: got %d and %d", defaultMigrationLockKey, a, b)
+	}
+}
+
+func TestAdvisoryLockKeyDistinct(t *testing.T) {
+	a := advisoryLockKey("pgconnect:migrator:group-a")
+	b := advisoryLockKey("pgconnect:migrator:group-b")
+	if a == b {
+		t.Errorf("advisoryLockKey produced the same key for distinct names: %d", a)
+	}
+}
@@ -1,5 +1,17 @@
 package pgconnect
 
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
 // Repository provides a generic repository pattern for database operations
 type Repository[T any] struct {
 	db *DB
@@ -60,3 +72,196 @@ func (r *Repository[T]) Paginate(result *[]T, page, pageSize int) error {
 	offset := (page - 1) * pageSize
 	return r.db.Offset(offset).Limit(pageSize).Find(result).Error
 }
+
+// PaginateKeyset retrieves up to limit records ordered by orderBy, starting
+// after cursor, and returns a Cursor for fetching the next page. Pass an
+// empty cursor to fetch the first page. Unlike Paginate, performance does
+// not degrade as the offset grows, since it filters on the order column
+// instead of skipping rows.
+func (r *Repository[T]) PaginateKeyset(result *[]T, cursor Cursor, limit int, orderBy string, desc bool) (Cursor, error) {
+	if err := r.validateColumn(orderBy); err != nil {
+		return "", err
+	}
+
+	op := ">"
+	if desc {
+		op = "<"
+	}
+
+	query := r.db.DB.Order(fmt.Sprintf("%s %s", orderBy, sortDirectionName(desc))).Limit(limit)
+
+	if cursor != "" {
+		payload, err := cursor.decode()
+		if err != nil {
+			return "", err
+		}
+		if payload.Column != orderBy || payload.Desc != desc {
+			return "", fmt.Errorf("pgconnect: cursor was issued for %q %s, not %q %s",
+				payload.Column, sortDirectionName(payload.Desc), orderBy, sortDirectionName(desc))
+		}
+		query = query.Where(fmt.Sprintf("%s %s ?", orderBy, op), payload.Value)
+	}
+
+	if err := query.Find(result).Error; err != nil {
+		return "", err
+	}
+
+	rows := *result
+	if len(rows) == 0 {
+		return "", nil
+	}
+
+	value, err := r.columnValue(rows[len(rows)-1], orderBy)
+	if err != nil {
+		return "", err
+	}
+
+	return encodeCursor(orderBy, value, desc)
+}
+
+// sortDirectionName returns the SQL ORDER BY keyword for desc.
+func sortDirectionName(desc bool) string {
+	if desc {
+		return "DESC"
+	}
+	return "ASC"
+}
+
+// schemaCache is shared across repositories and calls, mirroring GORM's own
+// schema cache, so repeated schema lookups don't re-parse the model on every
+// call.
+var schemaCache sync.Map
+
+// schema parses T's GORM schema using the repository's configured naming
+// strategy, reusing the package-level schemaCache across calls.
+func (r *Repository[T]) schema() (*schema.Schema, error) {
+	namer := r.db.Config.NamingStrategy
+	if namer == nil {
+		namer = schema.NamingStrategy{}
+	}
+
+	var model T
+	return schema.Parse(&model, &schemaCache, namer)
+}
+
+// validateColumn rejects any column that isn't one of T's known fields.
+// orderBy and similar parameters are often forwarded from caller-supplied
+// input (e.g. an API query parameter); without this check they would be
+// interpolated into raw SQL unescaped.
+func (r *Repository[T]) validateColumn(column string) error {
+	stmt, err := r.schema()
+	if err != nil {
+		return fmt.Errorf("pgconnect: failed to parse schema: %w", err)
+	}
+	if stmt.LookUpField(column) == nil {
+		return fmt.Errorf("pgconnect: %q is not a known column", column)
+	}
+	return nil
+}
+
+// validatedOrderClause validates and returns an ORDER BY clause of the form
+// "column" or "column ASC|DESC", rejecting anything else.
+func (r *Repository[T]) validatedOrderClause(orderBy string) (string, error) {
+	parts := strings.Fields(orderBy)
+	if len(parts) == 0 || len(parts) > 2 {
+		return "", fmt.Errorf("pgconnect: invalid order clause %q", orderBy)
+	}
+
+	column := parts[0]
+	if err := r.validateColumn(column); err != nil {
+		return "", err
+	}
+
+	if len(parts) == 1 {
+		return column, nil
+	}
+
+	direction := strings.ToUpper(parts[1])
+	if direction != "ASC" && direction != "DESC" {
+		return "", fmt.Errorf("pgconnect: invalid sort direction %q", parts[1])
+	}
+	return column + " " + direction, nil
+}
+
+// columnValue reads the value of column from model, resolving the struct
+// field via the repository's configured naming strategy.
+func (r *Repository[T]) columnValue(model T, column string) (interface{}, error) {
+	stmt, err := r.schema()
+	if err != nil {
+		return nil, fmt.Errorf("pgconnect: failed to parse schema: %w", err)
+	}
+
+	field := stmt.LookUpField(column)
+	if field == nil {
+		return nil, fmt.Errorf("pgconnect: no field maps to column %q", column)
+	}
+
+	return field.ReflectValueOf(context.Background(), reflect.ValueOf(&model).Elem()).Interface(), nil
+}
+
+// FindWithOrder finds records matching the given conditions, ordered by
+// orderBy (a single column, optionally suffixed with ASC or DESC).
+func (r *Repository[T]) FindWithOrder(result *[]T, orderBy string, query interface{}, args ...interface{}) error {
+	orderClause, err := r.validatedOrderClause(orderBy)
+	if err != nil {
+		return err
+	}
+
+	db := r.db.DB.Order(orderClause)
+	if query != nil {
+		db = db.Where(query, args...)
+	}
+	return db.Find(result).Error
+}
+
+// FindInBatches loads records batchSize rows at a time, invoking fn for each
+// batch, stopping early if fn returns an error.
+func (r *Repository[T]) FindInBatches(batchSize int, fn func([]T) error) error {
+	var batch []T
+	return r.db.DB.FindInBatches(&batch, batchSize, func(tx *gorm.DB, batchNumber int) error {
+		return fn(batch)
+	}).Error
+}
+
+// Upsert inserts model, or on a conflict over conflictCols updates updateCols
+// with the incoming values.
+func (r *Repository[T]) Upsert(model *T, conflictCols []string, updateCols []string) error {
+	columns := make([]clause.Column, len(conflictCols))
+	for i, c := range conflictCols {
+		columns[i] = clause.Column{Name: c}
+	}
+
+	return r.db.DB.Clauses(clause.OnConflict{
+		Columns:   columns,
+		DoUpdates: clause.AssignmentColumns(updateCols),
+	}).Create(model).Error
+}
+
+// CreateCtx inserts a new record, binding the query to ctx so plugins like
+// WithTracing see the caller's span as the parent.
+func (r *Repository[T]) CreateCtx(ctx context.Context, model *T) error {
+	return r.db.DB.WithContext(ctx).Create(model).Error
+}
+
+// FindByIDCtx retrieves a record by ID, binding the query to ctx.
+func (r *Repository[T]) FindByIDCtx(ctx context.Context, id interface{}, result *T) error {
+	return r.db.DB.WithContext(ctx).First(result, id).Error
+}
+
+// FindWhereCtx finds records matching the given conditions, binding the
+// query to ctx.
+func (r *Repository[T]) FindWhereCtx(ctx context.Context, result *[]T, query interface{}, args ...interface{}) error {
+	return r.db.DB.WithContext(ctx).Where(query, args...).Find(result).Error
+}
+
+// PaginateCtx retrieves records with pagination, binding the query to ctx.
+func (r *Repository[T]) PaginateCtx(ctx context.Context, result *[]T, page, pageSize int) error {
+	offset := (page - 1) * pageSize
+	return r.db.DB.WithContext(ctx).Offset(offset).Limit(pageSize).Find(result).Error
+}
+
+// WithTransactionCtx executes fn within a transaction bound to ctx, so spans
+// created inside fn share the caller's trace.
+func (r *Repository[T]) WithTransactionCtx(ctx context.Context, fn func(tx *gorm.DB) error) error {
+	return r.db.DB.WithContext(ctx).Transaction(fn)
+}
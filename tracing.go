@@ -0,0 +1,118 @@
+package pgconnect
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// tracingSpanKey is the gorm.DB instance-scoped key under which the
+// in-flight span is stashed between a callback's before and after hooks.
+const tracingSpanKey = "pgconnect:tracing:span"
+
+// tracingPlugin is a GORM plugin that emits an OpenTelemetry span around
+// every Create/Query/Update/Delete/Row/Raw callback.
+type tracingPlugin struct {
+	tracer trace.Tracer
+}
+
+// WithTracing returns a GORM plugin that records an OpenTelemetry span for
+// every Create/Query/Update/Delete/Row/Raw operation, including those run
+// inside a transaction, using tp (or the global TracerProvider if tp is
+// nil). Beginning, committing or rolling back a transaction records no span. Register it with db.Use(pgconnect.WithTracing(tp)), and use the
+// *Ctx Repository methods so the calling context's span is the parent.
+func WithTracing(tp trace.TracerProvider) gorm.Plugin {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	return &tracingPlugin{tracer: tp.Tracer("github.com/JorgeSaicoski/pgconnect")}
+}
+
+// Name identifies the plugin to GORM.
+func (p *tracingPlugin) Name() string {
+	return "pgconnect:tracing"
+}
+
+// Initialize registers the before/after hooks on every callback GORM
+// exposes. GORM exports no usable type for a callback processor (Callback()
+// and its Create/Query/... accessors return unexported types), so each
+// operation's processor is looked up by name inside the loop rather than
+// stored in a shared slice.
+func (p *tracingPlugin) Initialize(db *gorm.DB) error {
+	for _, name := range []string{"create", "query", "update", "delete", "row", "raw"} {
+		callbackName := "gorm:" + name
+
+		var err error
+		switch name {
+		case "create":
+			if err = db.Callback().Create().Before(callbackName).Register("pgconnect:before_"+name, p.before(name)); err == nil {
+				err = db.Callback().Create().After(callbackName).Register("pgconnect:after_"+name, p.after)
+			}
+		case "query":
+			if err = db.Callback().Query().Before(callbackName).Register("pgconnect:before_"+name, p.before(name)); err == nil {
+				err = db.Callback().Query().After(callbackName).Register("pgconnect:after_"+name, p.after)
+			}
+		case "update":
+			if err = db.Callback().Update().Before(callbackName).Register("pgconnect:before_"+name, p.before(name)); err == nil {
+				err = db.Callback().Update().After(callbackName).Register("pgconnect:after_"+name, p.after)
+			}
+		case "delete":
+			if err = db.Callback().Delete().Before(callbackName).Register("pgconnect:before_"+name, p.before(name)); err == nil {
+				err = db.Callback().Delete().After(callbackName).Register("pgconnect:after_"+name, p.after)
+			}
+		case "row":
+			if err = db.Callback().Row().Before(callbackName).Register("pgconnect:before_"+name, p.before(name)); err == nil {
+				err = db.Callback().Row().After(callbackName).Register("pgconnect:after_"+name, p.after)
+			}
+		case "raw":
+			if err = db.Callback().Raw().Before(callbackName).Register("pgconnect:before_"+name, p.before(name)); err == nil {
+				err = db.Callback().Raw().After(callbackName).Register("pgconnect:after_"+name, p.after)
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *tracingPlugin) before(operation string) func(*gorm.DB) {
+	return func(tx *gorm.DB) {
+		dbSystem := "unknown"
+		if tx.Dialector != nil {
+			dbSystem = tx.Dialector.Name()
+		}
+
+		ctx, span := p.tracer.Start(tx.Statement.Context, "gorm."+operation,
+			trace.WithAttributes(attribute.String("db.system", dbSystem)),
+		)
+		tx.Statement.Context = ctx
+		tx.InstanceSet(tracingSpanKey, span)
+	}
+}
+
+func (p *tracingPlugin) after(tx *gorm.DB) {
+	spanVal, ok := tx.InstanceGet(tracingSpanKey)
+	if !ok {
+		return
+	}
+	span, ok := spanVal.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.statement", tx.Statement.SQL.String()),
+		attribute.Int64("db.rows_affected", tx.Statement.RowsAffected),
+	)
+
+	if tx.Error != nil {
+		span.RecordError(tx.Error)
+		span.SetStatus(codes.Error, tx.Error.Error())
+	}
+}
@@ -0,0 +1,90 @@
+package pgconnect
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// ZapLoggerOptions configures the logger.Interface returned by WithZapLogger.
+type ZapLoggerOptions struct {
+	// SlowThreshold marks queries slower than this as warnings. Zero disables
+	// slow-query warnings.
+	SlowThreshold time.Duration
+	// IgnoreRecordNotFoundError suppresses error-level logging for
+	// gorm.ErrRecordNotFound, which is expected in most lookup code paths.
+	IgnoreRecordNotFoundError bool
+	// LogLevel caps which levels are emitted, mirroring logger.Config.LogLevel.
+	LogLevel logger.LogLevel
+}
+
+// zapGormLogger adapts a *zap.Logger into GORM's logger.Interface.
+type zapGormLogger struct {
+	zap *zap.Logger
+	ZapLoggerOptions
+}
+
+// WithZapLogger adapts z into GORM's logger.Interface according to opts, so
+// queries are emitted as structured, leveled logs instead of GORM's default
+// writer-based logger. Set it on Config.Logger to use it with New.
+func WithZapLogger(z *zap.Logger, opts ZapLoggerOptions) logger.Interface {
+	return &zapGormLogger{zap: z.WithOptions(zap.AddCallerSkip(1)), ZapLoggerOptions: opts}
+}
+
+// LogMode returns a copy of the logger with its level set to level.
+func (l *zapGormLogger) LogMode(level logger.LogLevel) logger.Interface {
+	newLogger := *l
+	newLogger.LogLevel = level
+	return &newLogger
+}
+
+// Info logs at GORM's Info level.
+func (l *zapGormLogger) Info(ctx context.Context, msg string, args ...interface{}) {
+	if l.LogLevel >= logger.Info {
+		l.zap.Sugar().Infof(msg, args...)
+	}
+}
+
+// Warn logs at GORM's Warn level.
+func (l *zapGormLogger) Warn(ctx context.Context, msg string, args ...interface{}) {
+	if l.LogLevel >= logger.Warn {
+		l.zap.Sugar().Warnf(msg, args...)
+	}
+}
+
+// Error logs at GORM's Error level.
+func (l *zapGormLogger) Error(ctx context.Context, msg string, args ...interface{}) {
+	if l.LogLevel >= logger.Error {
+		l.zap.Sugar().Errorf(msg, args...)
+	}
+}
+
+// Trace logs a single SQL statement's outcome, routing it to Error, Warn, or
+// Info depending on whether it failed, was slow, or succeeded normally.
+func (l *zapGormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	if l.LogLevel <= logger.Silent {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	sql, rows := fc()
+	fields := []zap.Field{
+		zap.Duration("elapsed", elapsed),
+		zap.Int64("rows", rows),
+		zap.String("sql", sql),
+	}
+
+	switch {
+	case err != nil && l.LogLevel >= logger.Error &&
+		!(l.IgnoreRecordNotFoundError && errors.Is(err, gorm.ErrRecordNotFound)):
+		l.zap.Error("gorm query failed", append(fields, zap.Error(err))...)
+	case l.SlowThreshold != 0 && elapsed > l.SlowThreshold && l.LogLevel >= logger.Warn:
+		l.zap.Warn("gorm slow query", fields...)
+	case l.LogLevel >= logger.Info:
+		l.zap.Info("gorm query", fields...)
+	}
+}
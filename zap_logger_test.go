@@ -0,0 +1,28 @@
+package pgconnect
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm/logger"
+)
+
+func TestWithZapLoggerLogMode(t *testing.T) {
+	l := WithZapLogger(zap.NewNop(), ZapLoggerOptions{LogLevel: logger.Warn})
+
+	warnLogger, ok := l.(*zapGormLogger)
+	if !ok {
+		t.Fatalf("WithZapLogger returned %T, want *zapGormLogger", l)
+	}
+	if warnLogger.LogLevel != logger.Warn {
+		t.Fatalf("LogLevel = %v, want %v", warnLogger.LogLevel, logger.Warn)
+	}
+
+	infoLogger := l.LogMode(logger.Info)
+	if warnLogger.LogLevel != logger.Warn {
+		t.Fatalf("LogMode mutated the original logger's level: %v", warnLogger.LogLevel)
+	}
+	if infoLogger.(*zapGormLogger).LogLevel != logger.Info {
+		t.Fatalf("LogMode did not apply the new level")
+	}
+}